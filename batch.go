@@ -0,0 +1,157 @@
+package skiplist
+
+import "sort"
+
+type batchOpKind int
+
+const (
+	batchInsert batchOpKind = iota
+	batchDelete
+)
+
+type batchEntry[K any, V any] struct {
+	key   K
+	kind  batchOpKind
+	value V
+}
+
+// BatchOptions configures a Batch.
+type BatchOptions struct {
+	// Sorted indicates the caller queues operations in ascending key
+	// order already, letting Commit skip sorting them. A duplicate key
+	// is then collapsed only when it repeats the key queued just before
+	// it.
+	Sorted bool
+}
+
+// Batch buffers a set of inserts and deletes to apply to a SkipList
+// atomically and efficiently: Commit acquires the list's lock once and
+// replays every queued operation, instead of once per operation.
+type Batch[K any, V any] struct {
+	list     *SkipList[K, V]
+	opts     BatchOptions
+	sorted   []batchEntry[K, V]
+	unsorted []batchEntry[K, V]
+}
+
+// NewBatch returns a Batch for queuing operations to apply to the list
+// via Commit.
+func (list *SkipList[K, V]) NewBatch(opts ...BatchOptions) *Batch[K, V] {
+	b := &Batch[K, V]{list: list}
+	if len(opts) > 0 {
+		b.opts = opts[0]
+	}
+
+	return b
+}
+
+func (b *Batch[K, V]) put(e batchEntry[K, V]) {
+	if b.opts.Sorted {
+		if n := len(b.sorted); n > 0 && b.list.cmp(b.sorted[n-1].key, e.key) == 0 {
+			b.sorted[n-1] = e
+		} else {
+			b.sorted = append(b.sorted, e)
+		}
+		return
+	}
+
+	// A linear scan collapses duplicate keys so the last write wins,
+	// even without ascending input order.
+	for i := range b.unsorted {
+		if b.list.cmp(b.unsorted[i].key, e.key) == 0 {
+			b.unsorted[i] = e
+			return
+		}
+	}
+	b.unsorted = append(b.unsorted, e)
+}
+
+// Insert queues an insert of value under key.
+func (b *Batch[K, V]) Insert(key K, value V) {
+	b.put(batchEntry[K, V]{key: key, kind: batchInsert, value: value})
+}
+
+// Delete queues the removal of key.
+func (b *Batch[K, V]) Delete(key K) {
+	var zero V
+	b.put(batchEntry[K, V]{key: key, kind: batchDelete, value: zero})
+}
+
+// Len returns the number of queued operations.
+func (b *Batch[K, V]) Len() int {
+	if b.opts.Sorted {
+		return len(b.sorted)
+	}
+	return len(b.unsorted)
+}
+
+// Reset discards every queued operation so the Batch can be reused.
+func (b *Batch[K, V]) Reset() {
+	if b.opts.Sorted {
+		b.sorted = b.sorted[:0]
+		return
+	}
+	b.unsorted = b.unsorted[:0]
+}
+
+func (b *Batch[K, V]) entries() []batchEntry[K, V] {
+	if b.opts.Sorted {
+		return b.sorted
+	}
+
+	entries := make([]batchEntry[K, V], len(b.unsorted))
+	copy(entries, b.unsorted)
+	sort.Slice(entries, func(i, j int) bool {
+		return b.list.cmp(entries[i].key, entries[j].key) < 0
+	})
+
+	return entries
+}
+
+// Commit applies every queued operation to the underlying list in a
+// single lock acquisition. Since the operations are already in
+// ascending key order, Commit walks the list once with a shared
+// per-level predecessor vector instead of redoing a full descent from
+// the head for every operation.
+func (b *Batch[K, V]) Commit() {
+	entries := b.entries()
+	if len(entries) == 0 {
+		return
+	}
+
+	list := b.list
+	list.mut.Lock()
+	defer list.mut.Unlock()
+
+	update := list.update
+	filled := list.level
+	for i := 0; i < filled; i++ {
+		update[i] = list.head
+	}
+
+	for _, e := range entries {
+		// insertAt for an earlier entry may have grown list.level; the
+		// newly introduced levels hold only nodes this Commit inserted,
+		// so list.head is always a valid predecessor for them and the
+		// descent below walks forward from it.
+		if list.level > filled {
+			for i := filled; i < list.level; i++ {
+				update[i] = list.head
+			}
+		}
+		filled = list.level
+
+		for i := list.level - 1; i >= 0; i-- {
+			for update[i].next[i] != nil && list.cmp(update[i].next[i].key, e.key) < 0 {
+				update[i] = update[i].next[i]
+			}
+		}
+
+		switch e.kind {
+		case batchInsert:
+			list.insertAt(update, e.key, e.value)
+		case batchDelete:
+			list.popAt(update, e.key)
+		}
+	}
+}
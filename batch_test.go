@@ -0,0 +1,89 @@
+package skiplist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchInsertAndDelete(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	list.Insert(1, 1)
+
+	b := list.NewBatch()
+	assert.Equal(t, 0, b.Len())
+
+	b.Insert(2, 2)
+	b.Insert(3, 3)
+	b.Delete(1)
+	assert.Equal(t, 3, b.Len())
+
+	b.Commit()
+
+	assert.Zero(t, list.Search(1))
+	assert.Equal(t, 2, list.Search(2))
+	assert.Equal(t, 3, list.Search(3))
+	assert.Equal(t, 2, list.Len())
+}
+
+func TestBatchLastWriteWins(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+
+	b := list.NewBatch()
+	b.Insert(1, 1)
+	b.Delete(1)
+	b.Insert(1, 2)
+	assert.Equal(t, 1, b.Len())
+
+	b.Commit()
+
+	assert.Equal(t, 2, list.Search(1))
+}
+
+func TestBatchReset(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+
+	b := list.NewBatch()
+	b.Insert(1, 1)
+	b.Reset()
+	assert.Equal(t, 0, b.Len())
+
+	b.Commit()
+	assert.Zero(t, list.Search(1))
+}
+
+func TestBatchSortedOption(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+
+	b := list.NewBatch(BatchOptions{Sorted: true})
+	b.Insert(1, 1)
+	b.Insert(2, 2)
+	b.Insert(2, 3)
+	b.Insert(3, 4)
+	assert.Equal(t, 3, b.Len())
+
+	b.Commit()
+
+	assert.Equal(t, 1, list.Search(1))
+	assert.Equal(t, 3, list.Search(2))
+	assert.Equal(t, 4, list.Search(3))
+	assert.Equal(t, 3, list.Len())
+}
+
+func TestBatchCommitEmptyIsNoop(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	b := list.NewBatch()
+	b.Commit()
+
+	assert.Equal(t, 0, list.Len())
+}
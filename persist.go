@@ -0,0 +1,239 @@
+package skiplist
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"math"
+)
+
+var (
+	formatMagic   = [4]byte{'S', 'K', 'P', 'L'}
+	formatVersion = byte(1)
+
+	errBadMagic   = fmt.Errorf("skiplist: data does not start with the skip list magic bytes")
+	errBadVersion = fmt.Errorf("skiplist: unsupported skip list format version")
+	errMaxLevel   = fmt.Errorf("skiplist: data was written with a larger maxLevel than this list allows")
+	errNodeLevel  = fmt.Errorf("skiplist: record has a level outside this list's maxLevel")
+)
+
+// headerSize is magic(4) + version(1) + maxLevel(1) + prob(8) + count(8).
+const headerSize = 4 + 1 + 1 + 8 + 8
+
+func gobEncode[T any](v T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gobDecode[T any](b []byte) (T, error) {
+	var v T
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&v); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
+// MarshalBinary encodes the list into a compact binary form: a small
+// header followed by one entry per element, keys and values encoded
+// with the list's key and value codecs (gob by default, see
+// WithKeyCodec and WithValueCodec).
+func (list *SkipList[K, V]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := list.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the list's contents with data previously
+// produced by MarshalBinary or WriteTo.
+func (list *SkipList[K, V]) UnmarshalBinary(data []byte) error {
+	_, err := list.ReadFrom(bytes.NewReader(data))
+	return err
+}
+
+// WriteTo streams the list to w in the same format as MarshalBinary. It
+// also records each node's tower height, so a later ReadFrom reproduces
+// the exact level structure without paying the randLevel cost again.
+func (list *SkipList[K, V]) WriteTo(w io.Writer) (int64, error) {
+	list.mut.RLock()
+	defer list.mut.RUnlock()
+
+	bw := bufio.NewWriter(w)
+
+	header := make([]byte, 0, headerSize)
+	header = append(header, formatMagic[:]...)
+	header = append(header, formatVersion, byte(list.maxLevel))
+	header = binary.BigEndian.AppendUint64(header, math.Float64bits(list.prob))
+	header = binary.BigEndian.AppendUint64(header, uint64(list.length))
+
+	n, err := bw.Write(header)
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+
+	for cur := list.head.next[0]; cur != nil; cur = cur.next[0] {
+		if cur.deletedSeq != 0 {
+			continue
+		}
+
+		nw, err := list.writeNode(bw, cur)
+		written += nw
+		if err != nil {
+			return written, err
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return written, err
+	}
+
+	return written, nil
+}
+
+func (list *SkipList[K, V]) writeNode(w *bufio.Writer, n *node[K, V]) (int64, error) {
+	var written int64
+
+	if err := w.WriteByte(byte(len(n.next))); err != nil {
+		return written, err
+	}
+	written++
+
+	keyBytes, err := list.encodeKey(n.key)
+	if err != nil {
+		return written, err
+	}
+	nw, err := writeChunk(w, keyBytes)
+	written += nw
+	if err != nil {
+		return written, err
+	}
+
+	valueBytes, err := list.encodeValue(n.value)
+	if err != nil {
+		return written, err
+	}
+	nw, err = writeChunk(w, valueBytes)
+	written += nw
+
+	return written, err
+}
+
+func writeChunk(w *bufio.Writer, b []byte) (int64, error) {
+	var lenBuf [4]byte
+	binary.BigEndian.PutUint32(lenBuf[:], uint32(len(b)))
+
+	n, err := w.Write(lenBuf[:])
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+
+	n, err = w.Write(b)
+	written += int64(n)
+	return written, err
+}
+
+// ReadFrom replaces the list's contents with data previously written by
+// WriteTo or MarshalBinary, restoring elements by replaying inserts in
+// ascending key order at their original tower height.
+func (list *SkipList[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	br := bufio.NewReader(r)
+
+	magic := make([]byte, 4)
+	n, err := io.ReadFull(br, magic)
+	read := int64(n)
+	if err != nil {
+		return read, err
+	}
+	if !bytes.Equal(magic, formatMagic[:]) {
+		return read, errBadMagic
+	}
+
+	rest := make([]byte, headerSize-4)
+	n, err = io.ReadFull(br, rest)
+	read += int64(n)
+	if err != nil {
+		return read, err
+	}
+	if rest[0] != formatVersion {
+		return read, errBadVersion
+	}
+	recordMaxLevel := int(rest[1])
+	count := binary.BigEndian.Uint64(rest[10:18])
+
+	list.mut.Lock()
+	defer list.mut.Unlock()
+
+	if recordMaxLevel > list.maxLevel {
+		return read, fmt.Errorf("%w: got %d, this list allows %d", errMaxLevel, recordMaxLevel, list.maxLevel)
+	}
+
+	list.clear()
+
+	for i := uint64(0); i < count; i++ {
+		level, nr, err := readLevel(br)
+		read += nr
+		if err != nil {
+			return read, err
+		}
+		if level < minLevel || level > list.maxLevel {
+			return read, fmt.Errorf("%w: got %d, this list allows [%d, %d]", errNodeLevel, level, minLevel, list.maxLevel)
+		}
+
+		keyBytes, nr, err := readChunk(br)
+		read += nr
+		if err != nil {
+			return read, err
+		}
+		key, err := list.decodeKey(keyBytes)
+		if err != nil {
+			return read, err
+		}
+
+		valueBytes, nr, err := readChunk(br)
+		read += nr
+		if err != nil {
+			return read, err
+		}
+		value, err := list.decodeValue(valueBytes)
+		if err != nil {
+			return read, err
+		}
+
+		list.insertWithLevel(key, value, level)
+	}
+
+	return read, nil
+}
+
+func readLevel(r *bufio.Reader) (int, int64, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(b), 1, nil
+}
+
+func readChunk(r *bufio.Reader) ([]byte, int64, error) {
+	var lenBuf [4]byte
+	n, err := io.ReadFull(r, lenBuf[:])
+	read := int64(n)
+	if err != nil {
+		return nil, read, err
+	}
+
+	size := binary.BigEndian.Uint32(lenBuf[:])
+	b := make([]byte, size)
+	n, err = io.ReadFull(r, b)
+	read += int64(n)
+	return b, read, err
+}
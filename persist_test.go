@@ -0,0 +1,133 @@
+package skiplist
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalUnmarshalBinaryRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	for i := 0; i < 100; i++ {
+		list.Insert(float64(i), i*i)
+	}
+
+	data, err := list.MarshalBinary()
+	assert.NoError(t, err)
+
+	loaded := NewOrdered[float64, int]()
+	assert.NoError(t, loaded.UnmarshalBinary(data))
+
+	assert.Equal(t, list.Len(), loaded.Len())
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, i*i, loaded.Search(float64(i)))
+	}
+}
+
+func TestWriteToReadFromStreaming(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	list.Insert(1, 10)
+	list.Insert(2, 20)
+	list.Insert(3, 30)
+
+	var buf bytes.Buffer
+	n, err := list.WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+
+	loaded := NewOrdered[float64, int]()
+	_, err = loaded.ReadFrom(&buf)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 10, loaded.Search(1))
+	assert.Equal(t, 20, loaded.Search(2))
+	assert.Equal(t, 30, loaded.Search(3))
+}
+
+func TestWriteToExcludesTombstones(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	list.Insert(1, 1)
+	list.Insert(2, 2)
+
+	snap := list.Snapshot()
+	defer snap.Close()
+
+	list.Pop(1)
+
+	var buf bytes.Buffer
+	_, err := list.WriteTo(&buf)
+	assert.NoError(t, err)
+
+	loaded := NewOrdered[float64, int]()
+	_, err = loaded.ReadFrom(&buf)
+	assert.NoError(t, err)
+
+	assert.Zero(t, loaded.Search(1))
+	assert.Equal(t, 2, loaded.Search(2))
+	assert.Equal(t, 1, loaded.Len())
+}
+
+func TestReadFromRejectsBadMagic(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	err := list.UnmarshalBinary([]byte("not a skip list"))
+	assert.ErrorIs(t, err, errBadMagic)
+}
+
+func TestReadFromRejectsMaxLevelTooLarge(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int](WithMaxLevel[float64, int](64))
+	list.Insert(1, 1)
+	data, err := list.MarshalBinary()
+	assert.NoError(t, err)
+
+	small := NewOrdered[float64, int](WithMaxLevel[float64, int](4))
+	err = small.UnmarshalBinary(data)
+	assert.ErrorIs(t, err, errMaxLevel)
+}
+
+func TestWithKeyAndValueCodec(t *testing.T) {
+	t.Parallel()
+
+	encodeKey := func(k int) ([]byte, error) {
+		return []byte(strconv.Itoa(k)), nil
+	}
+	decodeKey := func(b []byte) (int, error) {
+		return strconv.Atoi(string(b))
+	}
+	encodeValue := func(v string) ([]byte, error) {
+		return []byte(v), nil
+	}
+	decodeValue := func(b []byte) (string, error) {
+		return string(b), nil
+	}
+
+	list := NewOrdered[int, string](
+		WithKeyCodec[int, string](encodeKey, decodeKey),
+		WithValueCodec[int, string](encodeValue, decodeValue),
+	)
+	list.Insert(1, "one")
+	list.Insert(2, "two")
+
+	data, err := list.MarshalBinary()
+	assert.NoError(t, err)
+
+	loaded := NewOrdered[int, string](
+		WithKeyCodec[int, string](encodeKey, decodeKey),
+		WithValueCodec[int, string](encodeValue, decodeValue),
+	)
+	assert.NoError(t, loaded.UnmarshalBinary(data))
+
+	assert.Equal(t, "one", loaded.Search(1))
+	assert.Equal(t, "two", loaded.Search(2))
+}
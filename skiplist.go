@@ -1,6 +1,7 @@
 package skiplist
 
 import (
+	"cmp"
 	"fmt"
 	"math"
 	"math/rand"
@@ -17,96 +18,205 @@ const (
 )
 
 var maxLevelErr = fmt.Errorf("maxLevel for a SkipList must between [%d, %d]", minLevel, maxLevel)
-
-type node struct {
-	next  []*node
-	key   float64
-	value interface{}
+var noComparatorErr = fmt.Errorf("skiplist: New requires WithComparator (use NewOrdered for cmp.Ordered keys)")
+
+type node[K any, V any] struct {
+	next       []*node[K, V]
+	prev       *node[K, V]
+	key        K
+	value      V
+	createdSeq uint64
+	deletedSeq uint64
 }
 
-// SkipList implements a skip list structure.
+// SkipList implements a skip list structure keyed by K holding values V.
 // All operations are concurrency safe.
-type SkipList struct {
-	head       *node
-	maxLevel   int
-	level      int
-	prob       float64
-	probs      []float64
-	length     int
-	randSource rand.Source
-	mut        sync.RWMutex
-	update     []*node
-}
-
-// New creates a new skip list instance.
-func New(opts ...Option) *SkipList {
-	list := &SkipList{
-		head:       &node{},
-		maxLevel:   defaultMaxLevel,
-		level:      1,
-		prob:       defaultProb,
-		randSource: rand.NewSource(time.Now().UnixNano()),
+type SkipList[K any, V any] struct {
+	head          *node[K, V]
+	cmp           func(a, b K) int
+	maxLevel      int
+	level         int
+	prob          float64
+	probs         []float64
+	length        int
+	randSource    rand.Source
+	mut           sync.RWMutex
+	update        []*node[K, V]
+	seq           uint64
+	openSnapshots int
+	recycleNodes  bool
+	nodePool      sync.Pool
+	nextPools     []sync.Pool
+	encodeKey     func(k K) ([]byte, error)
+	decodeKey     func(b []byte) (K, error)
+	encodeValue   func(v V) ([]byte, error)
+	decodeValue   func(b []byte) (V, error)
+}
+
+// Any is a SkipList keyed by float64 with interface{} values, matching
+// the key and value types the pre-generics SkipList used. It does not
+// make old call sites keep compiling on its own: New and NewOrdered are
+// generic now, so callers still need to switch to
+// NewOrdered[float64, any](...) with explicit type arguments. Any just
+// saves having to spell out that instantiation at every use site.
+type Any = SkipList[float64, any]
+
+// New creates a new skip list. A comparator must be supplied via
+// WithComparator; New panics otherwise. Key types that already satisfy
+// cmp.Ordered can use NewOrdered instead.
+func New[K any, V any](opts ...Option[K, V]) *SkipList[K, V] {
+	list := &SkipList[K, V]{
+		maxLevel:    defaultMaxLevel,
+		level:       1,
+		prob:        defaultProb,
+		randSource:  rand.NewSource(time.Now().UnixNano()),
+		encodeKey:   gobEncode[K],
+		decodeKey:   gobDecode[K],
+		encodeValue: gobEncode[V],
+		decodeValue: gobDecode[V],
 	}
 
 	for _, opt := range opts {
 		opt(list)
 	}
 
-	list.head = &node{next: make([]*node, list.maxLevel, list.maxLevel)}
-	list.update = make([]*node, list.maxLevel, list.maxLevel)
+	if list.cmp == nil {
+		panic(noComparatorErr)
+	}
+
+	list.head = &node[K, V]{next: make([]*node[K, V], list.maxLevel, list.maxLevel)}
+	list.update = make([]*node[K, V], list.maxLevel, list.maxLevel)
 
 	list.makeProbs()
 
+	if list.recycleNodes {
+		list.nodePool.New = func() any {
+			return new(node[K, V])
+		}
+
+		list.nextPools = make([]sync.Pool, list.maxLevel+1)
+		for lvl := 1; lvl <= list.maxLevel; lvl++ {
+			lvl := lvl
+			list.nextPools[lvl].New = func() any {
+				return make([]*node[K, V], lvl, lvl)
+			}
+		}
+	}
+
 	return list
 }
 
-// Search finds a node by key. It returns the node value if found or nil.
-func (list *SkipList) Search(key float64) interface{} {
+// NewOrdered creates a new skip list for a key type K that satisfies
+// cmp.Ordered, plugging in cmp.Compare as the comparator automatically.
+func NewOrdered[K cmp.Ordered, V any](opts ...Option[K, V]) *SkipList[K, V] {
+	return New(append([]Option[K, V]{WithComparator[K, V](cmp.Compare[K])}, opts...)...)
+}
+
+// Search finds a node by key. It returns the node value if found or the
+// zero value of V.
+func (list *SkipList[K, V]) Search(key K) V {
 	list.mut.RLock()
 	defer list.mut.RUnlock()
 
 	cur := list.head
 	for i := list.level - 1; i >= 0; i-- {
-		for cur.next[i] != nil && cur.next[i].key < key {
+		for cur.next[i] != nil && list.cmp(cur.next[i].key, key) < 0 {
 			cur = cur.next[i]
 		}
 	}
 
-	if n := cur.next[0]; n != nil && n.key == key {
+	if n := cur.next[0]; n != nil && list.cmp(n.key, key) == 0 && n.deletedSeq == 0 {
 		return n.value
 	}
 
-	return nil
+	var zero V
+	return zero
 }
 
 // Insert adds a value into the list with the specified key.
 // it updates the node value if the key exists.
-func (list *SkipList) Insert(key float64, value interface{}) {
+func (list *SkipList[K, V]) Insert(key K, value V) {
 	list.mut.Lock()
 	defer list.mut.Unlock()
 
 	cur, update := list.head, list.update
 	for i := list.level - 1; i >= 0; i-- {
-		for cur.next[i] != nil && cur.next[i].key < key {
+		for cur.next[i] != nil && list.cmp(cur.next[i].key, key) < 0 {
 			cur = cur.next[i]
 		}
 
 		update[i] = cur
 	}
 
-	if n := cur.next[0]; n != nil && n.key == key {
-		n.value = value
-		return
+	list.insertAt(update, key, value)
+}
+
+// insertAt inserts or updates the node for key using update, an array
+// of per-level predecessors found by the caller's descent. If key
+// matches a tombstoned node (one popped while a snapshot was open), it
+// links a fresh node ahead of the tombstone instead of resurrecting it
+// in place, so a snapshot still pinning the tombstone keeps seeing it
+// rather than the new value; see Snapshot's docs. Likewise, if key
+// matches a live node while a snapshot is open, that node is
+// tombstoned and a fresh node is linked ahead of it rather than its
+// value being overwritten. It must be called with list.mut held for
+// writing.
+func (list *SkipList[K, V]) insertAt(update []*node[K, V], key K, value V) {
+	if n := update[0].next[0]; n != nil && list.cmp(n.key, key) == 0 && n.deletedSeq == 0 {
+		if list.openSnapshots == 0 {
+			n.value = value
+			return
+		}
+
+		// Snapshots may still be reading n; tombstone it and link a fresh
+		// node ahead of it instead of overwriting its value in place.
+		list.seq++
+		n.deletedSeq = list.seq
+		list.length--
 	}
 
+	list.seq++
+
 	// Get level for new node.
 	level := list.randLevel()
-	n := &node{
-		next:  make([]*node, level, level),
-		key:   key,
-		value: value,
+	n := list.newNode(level)
+	n.key = key
+	n.value = value
+	n.createdSeq = list.seq
+
+	list.linkNode(update, n)
+}
+
+// insertWithLevel behaves like insertAt, except it inserts a brand new
+// node at exactly the given level instead of drawing one from
+// randLevel. It is used by ReadFrom to reproduce the tower heights
+// recorded in a serialized list. It must be called with list.mut held
+// for writing.
+func (list *SkipList[K, V]) insertWithLevel(key K, value V, level int) {
+	cur, update := list.head, list.update
+	for i := list.level - 1; i >= 0; i-- {
+		for cur.next[i] != nil && list.cmp(cur.next[i].key, key) < 0 {
+			cur = cur.next[i]
+		}
+		update[i] = cur
 	}
 
+	list.seq++
+	n := list.newNode(level)
+	n.key = key
+	n.value = value
+	n.createdSeq = list.seq
+
+	list.linkNode(update, n)
+}
+
+// linkNode splices n, of its own level, into the list using update, an
+// array of per-level predecessors, and updates the bookkeeping shared
+// by every insertion path. It must be called with list.mut held for
+// writing.
+func (list *SkipList[K, V]) linkNode(update []*node[K, V], n *node[K, V]) {
+	level := len(n.next)
+
 	// Update every level list
 	for i := level - 1; i >= 0; i-- {
 		if update[i] != nil {
@@ -117,6 +227,14 @@ func (list *SkipList) Insert(key float64, value interface{}) {
 		}
 	}
 
+	// Maintain the level-0 back-pointer for reverse traversal.
+	if update[0] != list.head {
+		n.prev = update[0]
+	}
+	if n.next[0] != nil {
+		n.next[0].prev = n
+	}
+
 	if level > list.level {
 		// Update list level.
 		list.level = level
@@ -126,29 +244,48 @@ func (list *SkipList) Insert(key float64, value interface{}) {
 }
 
 // Delete removes a node by key from the list.
-func (list *SkipList) Delete(key float64) {
+func (list *SkipList[K, V]) Delete(key K) {
 	_ = list.Pop(key)
 }
 
 // Pop removes a node by key from the list.
-// It returns that node value if found or nil.
-func (list *SkipList) Pop(key float64) interface{} {
+// It returns that node value if found or the zero value of V.
+func (list *SkipList[K, V]) Pop(key K) V {
 	list.mut.Lock()
 	defer list.mut.Unlock()
 
 	cur, update := list.head, list.update
 	for i := list.level - 1; i >= 0; i-- {
-		for cur.next[i] != nil && cur.next[i].key < key {
+		for cur.next[i] != nil && list.cmp(cur.next[i].key, key) < 0 {
 			cur = cur.next[i]
 		}
 
 		update[i] = cur
 	}
 
-	var n *node
+	return list.popAt(update, key)
+}
+
+// popAt removes the node for key using update, an array of per-level
+// predecessors found by the caller's descent. It returns the removed
+// value, or the zero value of V if key isn't present. It must be
+// called with list.mut held for writing.
+func (list *SkipList[K, V]) popAt(update []*node[K, V], key K) V {
+	var n *node[K, V]
 	// Fast path, to see if key exists.
-	if n = update[0].next[0]; n == nil || n.key != key {
-		return nil
+	if n = update[0].next[0]; n == nil || list.cmp(n.key, key) != 0 || n.deletedSeq != 0 {
+		var zero V
+		return zero
+	}
+
+	list.seq++
+
+	if list.openSnapshots > 0 {
+		// Snapshots may still be reading this node; tombstone it instead
+		// of unlinking so it stays reachable until compact runs.
+		n.deletedSeq = list.seq
+		list.length--
+		return n.value
 	}
 
 	level := len(n.next)
@@ -156,6 +293,10 @@ func (list *SkipList) Pop(key float64) interface{} {
 		update[i].next[i] = n.next[i]
 	}
 
+	if n.next[0] != nil {
+		n.next[0].prev = n.prev
+	}
+
 	if level == list.level {
 		// Try to decrease level.
 		for i := level - 1; i >= 1; i-- {
@@ -168,11 +309,51 @@ func (list *SkipList) Pop(key float64) interface{} {
 
 	list.length--
 
-	return n.value
+	value := n.value
+	if list.recycleNodes {
+		list.recycle(n)
+	}
+
+	return value
+}
+
+// newNode returns a node with a next slice of the given level, drawing
+// both from the per-level sync.Pools when recycling is enabled.
+func (list *SkipList[K, V]) newNode(level int) *node[K, V] {
+	if !list.recycleNodes {
+		return &node[K, V]{next: make([]*node[K, V], level, level)}
+	}
+
+	n := list.nodePool.Get().(*node[K, V])
+	n.next = list.nextPools[level].Get().([]*node[K, V])
+	return n
+}
+
+// recycle returns n and its next slice to the per-list sync.Pools so a
+// future insert can reuse them instead of allocating. The caller must
+// not touch n again afterward.
+func (list *SkipList[K, V]) recycle(n *node[K, V]) {
+	level := len(n.next)
+	for i := range n.next {
+		n.next[i] = nil
+	}
+	list.nextPools[level].Put(n.next)
+
+	*n = node[K, V]{}
+	list.nodePool.Put(n)
+}
+
+// clear empties the list so that ReadFrom can rebuild it from scratch.
+// It must be called with list.mut held for writing.
+func (list *SkipList[K, V]) clear() {
+	list.head = &node[K, V]{next: make([]*node[K, V], list.maxLevel, list.maxLevel)}
+	list.update = make([]*node[K, V], list.maxLevel, list.maxLevel)
+	list.level = 1
+	list.length = 0
 }
 
 // Len returns length of the skip list.
-func (list *SkipList) Len() int {
+func (list *SkipList[K, V]) Len() int {
 	list.mut.Lock()
 	defer list.mut.Unlock()
 
@@ -180,7 +361,7 @@ func (list *SkipList) Len() int {
 }
 
 // String returns list info
-func (list *SkipList) String() string {
+func (list *SkipList[K, V]) String() string {
 	var sb strings.Builder
 
 	for i := 0; i < list.level; i++ {
@@ -196,7 +377,7 @@ func (list *SkipList) String() string {
 				prev = true
 			}
 			sb.WriteString("--> ")
-			sb.WriteString(fmt.Sprintf("%f(%v)", cur.key, cur.value))
+			sb.WriteString(fmt.Sprintf("%v(%v)", cur.key, cur.value))
 		}
 		sb.WriteString(" --> nil\n")
 	}
@@ -206,14 +387,14 @@ func (list *SkipList) String() string {
 
 const maxRand float64 = 1 << 63
 
-func (list *SkipList) randLevel() (lvl int) {
+func (list *SkipList[K, V]) randLevel() (lvl int) {
 	r := float64(list.randSource.Int63()) / maxRand
 	for lvl = 1; lvl < list.maxLevel && r < list.probs[lvl]; lvl++ {
 	}
 	return
 }
 
-func (list *SkipList) makeProbs() {
+func (list *SkipList[K, V]) makeProbs() {
 	list.probs = make([]float64, list.maxLevel, list.maxLevel)
 	for i := 1; i < list.maxLevel; i++ {
 		list.probs[i] = math.Pow(list.prob, float64(i))
@@ -221,12 +402,12 @@ func (list *SkipList) makeProbs() {
 }
 
 // Option specifies an option for skip list.
-type Option func(list *SkipList)
+type Option[K any, V any] func(list *SkipList[K, V])
 
 // WithMaxLevel specifies the max level for skip list.
 // It panics if max level isn't between [1, 64].
-func WithMaxLevel(level int) Option {
-	return func(list *SkipList) {
+func WithMaxLevel[K any, V any](level int) Option[K, V] {
+	return func(list *SkipList[K, V]) {
 		if level < minLevel || level > maxLevel {
 			panic(maxLevelErr)
 		}
@@ -235,15 +416,371 @@ func WithMaxLevel(level int) Option {
 }
 
 // WithProb specifies the probability for skip list.
-func WithProb(prob float64) Option {
-	return func(list *SkipList) {
+func WithProb[K any, V any](prob float64) Option[K, V] {
+	return func(list *SkipList[K, V]) {
 		list.prob = prob
 	}
 }
 
 // WithRandSource specifies the rand source for skip list.
-func WithRandSource(randSource rand.Source) Option {
-	return func(list *SkipList) {
+func WithRandSource[K any, V any](randSource rand.Source) Option[K, V] {
+	return func(list *SkipList[K, V]) {
 		list.randSource = randSource
 	}
 }
+
+// WithComparator specifies the key comparator for skip list. cmp must
+// return a negative number if a < b, zero if a == b, and a positive
+// number if a > b. New panics if no comparator is configured.
+func WithComparator[K any, V any](cmp func(a, b K) int) Option[K, V] {
+	return func(list *SkipList[K, V]) {
+		list.cmp = cmp
+	}
+}
+
+// WithNodeRecycling makes Insert and Pop draw nodes and their per-level
+// next slices from sync.Pools instead of allocating fresh ones every
+// time, cutting GC pressure on churn-heavy workloads. Leave it disabled
+// if callers keep references into a node's fields via reflection or
+// unsafe, since a recycled node is zeroed and reused for a different
+// key once it leaves the list.
+func WithNodeRecycling[K any, V any]() Option[K, V] {
+	return func(list *SkipList[K, V]) {
+		list.recycleNodes = true
+	}
+}
+
+// WithValueCodec overrides how values are encoded and decoded by
+// MarshalBinary, UnmarshalBinary, WriteTo and ReadFrom. It defaults to
+// gob.
+func WithValueCodec[K any, V any](encode func(v V) ([]byte, error), decode func([]byte) (V, error)) Option[K, V] {
+	return func(list *SkipList[K, V]) {
+		list.encodeValue = encode
+		list.decodeValue = decode
+	}
+}
+
+// WithKeyCodec overrides how keys are encoded and decoded by
+// MarshalBinary, UnmarshalBinary, WriteTo and ReadFrom. It defaults to
+// gob.
+func WithKeyCodec[K any, V any](encode func(k K) ([]byte, error), decode func([]byte) (K, error)) Option[K, V] {
+	return func(list *SkipList[K, V]) {
+		list.encodeKey = encode
+		list.decodeKey = decode
+	}
+}
+
+// Cursor provides ordered, bidirectional traversal over a SkipList, in
+// the style of Pebble's InternalIterator. A Cursor obtained from
+// NewIterator holds the list's read lock for its entire lifetime and
+// callers must call Close when done; a Cursor obtained from a Snapshot
+// only takes the lock for the duration of each call and Close is a
+// no-op, since the snapshot's refcount (not the lock) keeps its view
+// alive.
+type Cursor[K any, V any] struct {
+	list   *SkipList[K, V]
+	snap   *Snapshot[K, V]
+	cur    *node[K, V]
+	key    K
+	value  V
+	closed bool
+}
+
+// There is no separate Iterator type: NewIterator and
+// Snapshot.NewIterator both return a Cursor.
+
+// NewIterator returns a Cursor over the list, taking its read lock until
+// the cursor is closed.
+func (list *SkipList[K, V]) NewIterator() *Cursor[K, V] {
+	list.mut.RLock()
+	return &Cursor[K, V]{list: list}
+}
+
+// Range calls fn for every key in the half-open range [lo, hi) in
+// ascending order. It stops early if fn returns false.
+func (list *SkipList[K, V]) Range(lo, hi K, fn func(k K, v V) bool) {
+	c := list.NewIterator()
+	defer c.Close()
+
+	for ok := c.SeekGE(lo); ok; ok = c.Next() {
+		if list.cmp(c.Key(), hi) >= 0 {
+			break
+		}
+		if !fn(c.Key(), c.Value()) {
+			break
+		}
+	}
+}
+
+func (c *Cursor[K, V]) rlock() {
+	if c.snap != nil {
+		c.list.mut.RLock()
+	}
+}
+
+func (c *Cursor[K, V]) runlock() {
+	if c.snap != nil {
+		c.list.mut.RUnlock()
+	}
+}
+
+func (c *Cursor[K, V]) visible(n *node[K, V]) bool {
+	if c.snap != nil {
+		return c.snap.visible(n)
+	}
+	return n.deletedSeq == 0
+}
+
+// setCur positions the cursor at n, copying out its key and value while
+// the caller still holds the list's lock so Key and Value never read a
+// node's fields concurrently with a writer (relevant for a Snapshot
+// cursor, which only holds the lock for the duration of each call).
+func (c *Cursor[K, V]) setCur(n *node[K, V]) bool {
+	c.cur = n
+	if n != nil {
+		c.key = n.key
+		c.value = n.value
+	}
+	return c.cur != nil
+}
+
+// skipForward walks past nodes not visible to this cursor's snapshot
+// (nil for a non-snapshot cursor, so nothing is skipped).
+func (c *Cursor[K, V]) skipForward(n *node[K, V]) *node[K, V] {
+	for n != nil && !c.visible(n) {
+		n = n.next[0]
+	}
+	return n
+}
+
+func (c *Cursor[K, V]) skipBackward(n *node[K, V]) *node[K, V] {
+	for n != nil && !c.visible(n) {
+		n = n.prev
+	}
+	return n
+}
+
+// SeekGE positions the cursor at the first node with a key >= key. It
+// returns false if no such node exists.
+func (c *Cursor[K, V]) SeekGE(key K) bool {
+	c.rlock()
+	defer c.runlock()
+
+	cur := c.list.head
+	for i := c.list.level - 1; i >= 0; i-- {
+		for cur.next[i] != nil && c.list.cmp(cur.next[i].key, key) < 0 {
+			cur = cur.next[i]
+		}
+	}
+	return c.setCur(c.skipForward(cur.next[0]))
+}
+
+// SeekLT positions the cursor at the last node with a key < key. It
+// returns false if no such node exists.
+func (c *Cursor[K, V]) SeekLT(key K) bool {
+	c.rlock()
+	defer c.runlock()
+
+	cur := c.list.head
+	for i := c.list.level - 1; i >= 0; i-- {
+		for cur.next[i] != nil && c.list.cmp(cur.next[i].key, key) < 0 {
+			cur = cur.next[i]
+		}
+	}
+	if cur == c.list.head {
+		return c.setCur(nil)
+	}
+	return c.setCur(c.skipBackward(cur))
+}
+
+// First positions the cursor at the smallest key in the list.
+func (c *Cursor[K, V]) First() bool {
+	c.rlock()
+	defer c.runlock()
+
+	return c.setCur(c.skipForward(c.list.head.next[0]))
+}
+
+// Last positions the cursor at the largest key in the list.
+func (c *Cursor[K, V]) Last() bool {
+	c.rlock()
+	defer c.runlock()
+
+	cur := c.list.head
+	for i := c.list.level - 1; i >= 0; i-- {
+		for cur.next[i] != nil {
+			cur = cur.next[i]
+		}
+	}
+	if cur == c.list.head {
+		return c.setCur(nil)
+	}
+	return c.setCur(c.skipBackward(cur))
+}
+
+// Next advances the cursor to the next key in ascending order.
+func (c *Cursor[K, V]) Next() bool {
+	if c.cur == nil {
+		return false
+	}
+	c.rlock()
+	defer c.runlock()
+
+	return c.setCur(c.skipForward(c.cur.next[0]))
+}
+
+// Prev moves the cursor to the previous key in ascending order.
+func (c *Cursor[K, V]) Prev() bool {
+	if c.cur == nil {
+		return false
+	}
+	c.rlock()
+	defer c.runlock()
+
+	return c.setCur(c.skipBackward(c.cur.prev))
+}
+
+// Valid reports whether the cursor is positioned at a node.
+func (c *Cursor[K, V]) Valid() bool {
+	return c.cur != nil
+}
+
+// Key returns the key at the cursor's current position.
+func (c *Cursor[K, V]) Key() K {
+	return c.key
+}
+
+// Value returns the value at the cursor's current position.
+func (c *Cursor[K, V]) Value() V {
+	return c.value
+}
+
+// Close releases the read lock taken by NewIterator. It is a no-op for
+// a Cursor obtained from a Snapshot, and safe to call more than once.
+func (c *Cursor[K, V]) Close() {
+	if c.closed || c.snap != nil {
+		c.closed = true
+		return
+	}
+	c.closed = true
+	c.list.mut.RUnlock()
+}
+
+// Snapshot is an immutable, point-in-time view of a SkipList: Search,
+// Range and NewIterator all see the list exactly as it was when
+// Snapshot was taken, regardless of later inserts or deletes. Close must
+// be called once the snapshot is no longer needed; without any open
+// snapshots, behavior and memory use are identical to a SkipList with
+// no snapshot support at all.
+type Snapshot[K any, V any] struct {
+	list   *SkipList[K, V]
+	seq    uint64
+	closed bool
+}
+
+// Snapshot takes an immutable, point-in-time view of the list.
+func (list *SkipList[K, V]) Snapshot() *Snapshot[K, V] {
+	list.mut.Lock()
+	defer list.mut.Unlock()
+
+	list.openSnapshots++
+	return &Snapshot[K, V]{list: list, seq: list.seq}
+}
+
+func (s *Snapshot[K, V]) visible(n *node[K, V]) bool {
+	if n.createdSeq > s.seq {
+		return false
+	}
+	return n.deletedSeq == 0 || n.deletedSeq > s.seq
+}
+
+// Search finds a node by key as of the snapshot's sequence. It returns
+// the node value if found or the zero value of V.
+func (s *Snapshot[K, V]) Search(key K) V {
+	c := s.NewIterator()
+	if c.SeekGE(key) && s.list.cmp(c.Key(), key) == 0 {
+		return c.Value()
+	}
+	var zero V
+	return zero
+}
+
+// Range calls fn for every key in the half-open range [lo, hi), as of
+// the snapshot's sequence, in ascending order. It stops early if fn
+// returns false.
+func (s *Snapshot[K, V]) Range(lo, hi K, fn func(k K, v V) bool) {
+	c := s.NewIterator()
+	for ok := c.SeekGE(lo); ok; ok = c.Next() {
+		if s.list.cmp(c.Key(), hi) >= 0 {
+			break
+		}
+		if !fn(c.Key(), c.Value()) {
+			break
+		}
+	}
+}
+
+// NewIterator returns a Cursor over the list as of the snapshot's
+// sequence. Unlike a list-level Cursor it does not hold the read lock
+// between calls, so it does not block writers during a long scan.
+func (s *Snapshot[K, V]) NewIterator() *Cursor[K, V] {
+	return &Cursor[K, V]{list: s.list, snap: s}
+}
+
+// Close releases the snapshot. Closing the last open snapshot on a list
+// runs compact right away, which physically removes tombstoned nodes.
+func (s *Snapshot[K, V]) Close() {
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	s.list.mut.Lock()
+	defer s.list.mut.Unlock()
+
+	s.list.openSnapshots--
+	if s.list.openSnapshots == 0 {
+		s.list.compact()
+	}
+}
+
+// compact physically unlinks tombstoned nodes left behind by Pop while
+// snapshots were open. It must be called with list.mut held for writing.
+func (list *SkipList[K, V]) compact() {
+	var removed []*node[K, V]
+
+	for i := 0; i < list.level; i++ {
+		cur := list.head
+		for cur.next[i] != nil {
+			if cur.next[i].deletedSeq != 0 {
+				if i == 0 {
+					removed = append(removed, cur.next[i])
+				}
+				cur.next[i] = cur.next[i].next[i]
+				continue
+			}
+			cur = cur.next[i]
+		}
+	}
+
+	cur := list.head
+	for cur.next[0] != nil {
+		nxt := cur.next[0]
+		if cur == list.head {
+			nxt.prev = nil
+		} else {
+			nxt.prev = cur
+		}
+		cur = nxt
+	}
+
+	for list.level > minLevel && list.head.next[list.level-1] == nil {
+		list.level--
+	}
+
+	if list.recycleNodes {
+		for _, n := range removed {
+			list.recycle(n)
+		}
+	}
+}
@@ -11,7 +11,7 @@ import (
 )
 
 func ExampleNew() {
-	list := New(WithRandSource(rand.NewSource(2)))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)))
 	list.Insert(1, 1)
 	list.Insert(2, 2)
 	list.Insert(3, 3)
@@ -19,9 +19,17 @@ func ExampleNew() {
 	fmt.Println(list.String())
 
 	// output:
-	// level  1 --> 1.000000(1) <--> 2.000000(2) <--> 3.000000(3) --> nil
-	// level  2 --> 1.000000(1) <--> 2.000000(2) <--> 3.000000(3) --> nil
-	// level  3 --> 3.000000(3) --> nil
+	// level  1 --> 1(1) <--> 2(2) <--> 3(3) --> nil
+	// level  2 --> 1(1) <--> 2(2) <--> 3(3) --> nil
+	// level  3 --> 3(3) --> nil
+}
+
+func TestNewPanicsWithoutComparator(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		New[float64, int]()
+	})
 }
 
 func TestWithMaxLevel(t *testing.T) {
@@ -29,18 +37,18 @@ func TestWithMaxLevel(t *testing.T) {
 
 	t.Run("less than min level", func(t *testing.T) {
 		assert.Panics(t, func() {
-			New(WithMaxLevel(0))
+			NewOrdered[float64, int](WithMaxLevel[float64, int](0))
 		})
 	})
 
 	t.Run("big than max level", func(t *testing.T) {
 		assert.Panics(t, func() {
-			New(WithMaxLevel(65))
+			NewOrdered[float64, int](WithMaxLevel[float64, int](65))
 		})
 	})
 
 	t.Run("set custom max level", func(t *testing.T) {
-		list := New(WithMaxLevel(11))
+		list := NewOrdered[float64, int](WithMaxLevel[float64, int](11))
 		assert.Equal(t, 11, list.maxLevel)
 	})
 }
@@ -48,26 +56,39 @@ func TestWithMaxLevel(t *testing.T) {
 func TestWithProb(t *testing.T) {
 	t.Parallel()
 
-	list := New(WithProb(0.1))
+	list := NewOrdered[float64, int](WithProb[float64, int](0.1))
 	assert.Equal(t, 0.1, list.prob)
 }
 
 func TestWithRandSource(t *testing.T) {
 	t.Parallel()
 
-	list := New(WithRandSource(rand.NewSource(2)))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)))
 	assert.Equal(t, int64(1543039099823358511), list.randSource.Int63())
 }
 
-func TestDisableMutex(t *testing.T) {
+func TestWithNodeRecycling(t *testing.T) {
 	t.Parallel()
 
-	list := New(DisableMutex())
-	assert.Equal(t, true, list.disableMutex)
+	list := NewOrdered[float64, int](WithNodeRecycling[float64, int](), WithRandSource[float64, int](rand.NewSource(2)))
+	assert.True(t, list.recycleNodes)
+
+	list.Insert(1, 1)
+	list.Insert(2, 2)
+	assert.Equal(t, 1, list.Search(1))
+	assert.Equal(t, 2, list.Search(2))
+
+	list.Delete(1)
+	assert.Zero(t, list.Search(1))
+	assert.Equal(t, 2, list.Search(2))
+
+	list.Insert(3, 3)
+	assert.Equal(t, 3, list.Search(3))
+	assert.Equal(t, 2, list.Len())
 }
 
 func TestMakeProbs(t *testing.T) {
-	list := &SkipList{
+	list := &SkipList[float64, int]{
 		maxLevel: 4,
 		prob:     defaultProb,
 	}
@@ -81,7 +102,7 @@ func TestMakeProbs(t *testing.T) {
 }
 
 func ExampleSkipList_Search() {
-	list := New()
+	list := NewOrdered[float64, int]()
 	fmt.Println(list.Search(1))
 
 	list.Insert(1, 1)
@@ -92,18 +113,18 @@ func ExampleSkipList_Search() {
 	fmt.Println(list.Search(2))
 	fmt.Println(list.Search(3))
 	// output:
-	// <nil>
+	// 0
 	// 1
 	// 1
 	// 2
-	// <nil>
+	// 0
 }
 
 func TestSearch(t *testing.T) {
 	t.Parallel()
 
-	list := New()
-	assert.Nil(t, list.Search(1))
+	list := NewOrdered[float64, int]()
+	assert.Zero(t, list.Search(1))
 
 	list.Insert(1, 1)
 	assert.Equal(t, 1, list.Search(1))
@@ -112,41 +133,41 @@ func TestSearch(t *testing.T) {
 	assert.Equal(t, 1, list.Search(1))
 	assert.Equal(t, 2, list.Search(2))
 
-	assert.Nil(t, list.Search(3))
+	assert.Zero(t, list.Search(3))
 }
 
 func TestInsert(t *testing.T) {
 	t.Parallel()
 
 	// randLevel will get 3 2 5
-	list := New(WithRandSource(rand.NewSource(2)), WithProb(0.5))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)), WithProb[float64, int](0.5))
 
 	list.Insert(1, 1)
 	n1 := list.head.next[0]
 	assert.Equal(t, 3, len(n1.next))
 	assert.Equal(t, 3, list.level)
-	assert.Equal(t, 1, list.Size())
+	assert.Equal(t, 1, list.Len())
 
 	list.Insert(3, 3)
 	n3 := list.head.next[0].next[0]
 	assert.Equal(t, 2, len(n3.next))
 	assert.Equal(t, 3, list.level)
-	assert.Equal(t, 2, list.Size())
+	assert.Equal(t, 2, list.Len())
 
 	list.Insert(2, 2)
 	n2 := list.head.next[0].next[0]
 	assert.Equal(t, 5, len(n2.next))
 	assert.Equal(t, 5, list.level)
-	assert.Equal(t, 3, list.Size())
+	assert.Equal(t, 3, list.Len())
 
 	list.Insert(3, 4)
 	n3 = list.head.next[0].next[0].next[0]
 	assert.Equal(t, 4, n3.value)
-	assert.Equal(t, 3, list.Size())
+	assert.Equal(t, 3, list.Len())
 }
 
 func ExampleSkipList_Delete() {
-	list := New(WithRandSource(rand.NewSource(2)))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)))
 	list.Insert(1, 1)
 	list.Insert(2, 2)
 	list.Insert(3, 3)
@@ -156,42 +177,42 @@ func ExampleSkipList_Delete() {
 	fmt.Println(list.String())
 
 	// output:
-	// level  1 --> 1.000000(1) <--> 3.000000(3) --> nil
-	// level  2 --> 1.000000(1) <--> 3.000000(3) --> nil
-	// level  3 --> 3.000000(3) --> nil
+	// level  1 --> 1(1) <--> 3(3) --> nil
+	// level  2 --> 1(1) <--> 3(3) --> nil
+	// level  3 --> 3(3) --> nil
 }
 
 func TestDelete(t *testing.T) {
 	t.Parallel()
 
 	// randLevel will get 3 2 5
-	list := New(WithRandSource(rand.NewSource(2)), WithProb(0.5))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)), WithProb[float64, int](0.5))
 	list.Insert(1, 1)
 	list.Insert(2, 2)
 	list.Insert(3, 3)
 
 	assert.Equal(t, 5, list.level)
-	assert.Equal(t, 3, list.Size())
+	assert.Equal(t, 3, list.Len())
 
 	list.Delete(1)
 	assert.Equal(t, 5, list.level)
-	assert.Equal(t, 2, list.Size())
+	assert.Equal(t, 2, list.Len())
 
 	list.Delete(3)
 	assert.Equal(t, 2, list.level)
-	assert.Equal(t, 1, list.Size())
+	assert.Equal(t, 1, list.Len())
 
 	list.Delete(3)
 	assert.Equal(t, 2, list.level)
-	assert.Equal(t, 1, list.Size())
+	assert.Equal(t, 1, list.Len())
 
 	list.Delete(2)
 	assert.Equal(t, 1, list.level)
-	assert.Equal(t, 0, list.Size())
+	assert.Equal(t, 0, list.Len())
 }
 
 func ExampleSkipList_Pop() {
-	list := New(WithRandSource(rand.NewSource(2)))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)))
 	list.Insert(1, 1)
 	list.Insert(2, 2)
 	list.Insert(3, 3)
@@ -203,16 +224,16 @@ func ExampleSkipList_Pop() {
 
 	// output:
 	// 2
-	// <nil>
-	// level  1 --> 1.000000(1) <--> 3.000000(3) --> nil
-	// level  2 --> 1.000000(1) <--> 3.000000(3) --> nil
-	// level  3 --> 3.000000(3) --> nil
+	// 0
+	// level  1 --> 1(1) <--> 3(3) --> nil
+	// level  2 --> 1(1) <--> 3(3) --> nil
+	// level  3 --> 3(3) --> nil
 }
 
 func TestPop(t *testing.T) {
 	t.Parallel()
 
-	list := New()
+	list := NewOrdered[float64, int]()
 	list.Insert(1, 1)
 	list.Insert(2, 2)
 	list.Insert(3, 3)
@@ -220,42 +241,14 @@ func TestPop(t *testing.T) {
 	assert.Equal(t, 1, list.Pop(1))
 	assert.Equal(t, 2, list.Pop(2))
 	assert.Equal(t, 3, list.Pop(3))
-	assert.Equal(t, nil, list.Pop(4))
-}
-
-func ExampleSkipList_Clear() {
-	list := New(WithRandSource(rand.NewSource(2)))
-	list.Insert(1, 1)
-	list.Insert(2, 2)
-	list.Insert(3, 3)
-
-	list.Clear()
-
-	fmt.Println(list.String())
-
-	// output:
-	// level  1 --> nil
-}
-
-func TestClear(t *testing.T) {
-	t.Parallel()
-
-	list := New()
-	list.Insert(1, 1)
-	list.Insert(2, 2)
-	list.Insert(3, 3)
-
-	list.Clear()
-	assert.Equal(t, 0, list.Size())
-	assert.Equal(t, true, list.Empty())
-	assert.Equal(t, "level  1 --> nil\n", list.String())
+	assert.Equal(t, 0, list.Pop(4))
 }
 
 func TestString(t *testing.T) {
 	t.Parallel()
 
 	// randLevel will get 3 2 5
-	list := New(WithRandSource(rand.NewSource(2)), WithProb(0.5))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)), WithProb[float64, int](0.5))
 
 	var lines []string
 
@@ -279,6 +272,195 @@ func TestString(t *testing.T) {
 	assert.Contains(t, lines[4], "(3)")
 }
 
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	list.Insert(1, 1)
+	list.Insert(2, 2)
+	list.Insert(3, 3)
+
+	c := list.NewIterator()
+	defer c.Close()
+
+	assert.True(t, c.First())
+	assert.Equal(t, float64(1), c.Key())
+	assert.Equal(t, 1, c.Value())
+
+	assert.True(t, c.Next())
+	assert.Equal(t, float64(2), c.Key())
+
+	assert.True(t, c.Next())
+	assert.Equal(t, float64(3), c.Key())
+
+	assert.False(t, c.Next())
+	assert.False(t, c.Valid())
+
+	assert.True(t, c.Last())
+	assert.Equal(t, float64(3), c.Key())
+
+	assert.True(t, c.Prev())
+	assert.Equal(t, float64(2), c.Key())
+
+	assert.True(t, c.SeekGE(2))
+	assert.Equal(t, float64(2), c.Key())
+
+	assert.True(t, c.SeekGE(2.5))
+	assert.Equal(t, float64(3), c.Key())
+
+	assert.False(t, c.SeekGE(4))
+
+	assert.True(t, c.SeekLT(2))
+	assert.Equal(t, float64(1), c.Key())
+
+	assert.False(t, c.SeekLT(1))
+}
+
+func TestCursorEmptyList(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	c := list.NewIterator()
+	defer c.Close()
+
+	assert.False(t, c.First())
+	assert.False(t, c.Last())
+}
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	for i := 1; i <= 5; i++ {
+		list.Insert(float64(i), i)
+	}
+
+	var got []int
+	list.Range(2, 4, func(k float64, v int) bool {
+		got = append(got, v)
+		return true
+	})
+	assert.Equal(t, []int{2, 3}, got)
+
+	got = nil
+	list.Range(1, 6, func(k float64, v int) bool {
+		got = append(got, v)
+		return v < 3
+	})
+	assert.Equal(t, []int{1, 2, 3}, got)
+}
+
+func TestSnapshotIsolatesFromLaterWrites(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	list.Insert(1, 1)
+	list.Insert(2, 2)
+
+	snap := list.Snapshot()
+	defer snap.Close()
+
+	list.Delete(1)
+	list.Insert(3, 3)
+
+	assert.Equal(t, 1, snap.Search(1))
+	assert.Zero(t, snap.Search(3))
+
+	assert.Zero(t, list.Search(1))
+	assert.Equal(t, 3, list.Search(3))
+
+	var got []int
+	snap.Range(0, 10, func(k float64, v int) bool {
+		got = append(got, v)
+		return true
+	})
+	assert.Equal(t, []int{1, 2}, got)
+}
+
+func TestSnapshotCloseCompactsTombstones(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	list.Insert(1, 1)
+	list.Insert(2, 2)
+
+	snap := list.Snapshot()
+	list.Delete(1)
+
+	assert.Equal(t, float64(1), list.head.next[0].key)
+	assert.NotZero(t, list.head.next[0].deletedSeq)
+
+	snap.Close()
+
+	assert.Equal(t, float64(2), list.head.next[0].key)
+	assert.Equal(t, 1, list.Len())
+}
+
+func TestSnapshotWithNoWritesMatchesCurrentReads(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	list.Insert(1, 1)
+	list.Insert(2, 2)
+
+	snap := list.Snapshot()
+	defer snap.Close()
+
+	assert.Equal(t, list.Search(1), snap.Search(1))
+	assert.Equal(t, list.Search(2), snap.Search(2))
+}
+
+func TestSnapshotSurvivesResurrectedKey(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	list.Insert(1, 100)
+
+	snap := list.Snapshot()
+	defer snap.Close()
+
+	list.Delete(1)
+	list.Insert(1, 999)
+
+	assert.Equal(t, 100, snap.Search(1))
+	assert.Equal(t, 999, list.Search(1))
+}
+
+func TestSnapshotCursorRaceWithConcurrentInsert(t *testing.T) {
+	t.Parallel()
+
+	list := NewOrdered[float64, int]()
+	list.Insert(1, 1)
+
+	snap := list.Snapshot()
+	defer snap.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			list.Insert(1, i)
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		c := snap.NewIterator()
+		if c.SeekGE(1) {
+			_ = c.Value()
+		}
+		c.Close()
+	}
+	<-done
+}
+
+func TestAnyAliasKeepsOldMethodSet(t *testing.T) {
+	t.Parallel()
+
+	var list *Any = NewOrdered[float64, any](WithRandSource[float64, any](rand.NewSource(2)))
+	list.Insert(1, "one")
+	assert.Equal(t, "one", list.Search(1))
+}
+
 var searchResult interface{} = nil
 
 // go test -v -run=^$ -bench=BenchmarkSearch -benchmem -count=4
@@ -299,7 +481,7 @@ func BenchmarkSearch1000000(b *testing.B) {
 }
 
 func benchmarkSearch(b *testing.B, n int) {
-	list := New(WithRandSource(rand.NewSource(2)))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)))
 	for i := 0; i < n; i++ {
 		list.Insert(float64(n-i), i)
 	}
@@ -337,7 +519,44 @@ func BenchmarkInsertAndDelete1000000(b *testing.B) {
 }
 
 func benchmarkInsertAndDelete(b *testing.B, n int) {
-	list := New(WithRandSource(rand.NewSource(2)))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)))
+	for i := 0; i < n; i++ {
+		list.Insert(float64(n-i), i)
+	}
+	target := float64(n / 2)
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			list.Delete(target)
+			list.Insert(target, n/2)
+		}
+	})
+}
+
+// go test -v -run=^$ -bench=BenchmarkInsertAndDeleteWithRecycling -benchmem -count=4
+func BenchmarkInsertAndDeleteWithRecycling100(b *testing.B) {
+	benchmarkInsertAndDeleteWithRecycling(b, 100)
+}
+
+func BenchmarkInsertAndDeleteWithRecycling1000(b *testing.B) {
+	benchmarkInsertAndDeleteWithRecycling(b, 1000)
+}
+
+func BenchmarkInsertAndDeleteWithRecycling10000(b *testing.B) {
+	benchmarkInsertAndDeleteWithRecycling(b, 10000)
+}
+
+func BenchmarkInsertAndDeleteWithRecycling100000(b *testing.B) {
+	benchmarkInsertAndDeleteWithRecycling(b, 100000)
+}
+
+func BenchmarkInsertAndDeleteWithRecycling1000000(b *testing.B) {
+	benchmarkInsertAndDeleteWithRecycling(b, 1000000)
+}
+
+func benchmarkInsertAndDeleteWithRecycling(b *testing.B, n int) {
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)), WithNodeRecycling[float64, int]())
 	for i := 0; i < n; i++ {
 		list.Insert(float64(n-i), i)
 	}
@@ -378,7 +597,7 @@ func benchmarkBestInsert(b *testing.B, n int) {
 	b.ResetTimer()
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
-			list := New(WithRandSource(rand.NewSource(2)))
+			list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)))
 			for j := 0; j < n; j++ {
 				list.Insert(float64(n-j), j)
 			}
@@ -412,7 +631,7 @@ func benchmarkWorstInsert(b *testing.B, n int) {
 	b.ResetTimer()
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
-			list := New()
+			list := NewOrdered[float64, int]()
 			for j := 0; j < n; j++ {
 				list.Insert(float64(j), j)
 			}
@@ -442,7 +661,7 @@ func BenchmarkBestDelete1000000(b *testing.B) {
 }
 
 func benchmarkBestDelete(b *testing.B, n int) {
-	list := New(WithRandSource(rand.NewSource(2)))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)))
 	for i := 0; i < n; i++ {
 		list.Insert(float64(n-i), i)
 	}
@@ -477,7 +696,7 @@ func BenchmarkWorstDelete1000000(b *testing.B) {
 }
 
 func benchmarkWorstDelete(b *testing.B, n int) {
-	list := New(WithRandSource(rand.NewSource(2)))
+	list := NewOrdered[float64, int](WithRandSource[float64, int](rand.NewSource(2)))
 	for i := 0; i < n; i++ {
 		list.Insert(float64(n-i), i)
 	}